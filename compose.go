@@ -0,0 +1,156 @@
+package yopmail
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ComposeAttachment is a file to attach to a message sent via SendMail.
+//
+// UNVERIFIED: the multipart field name SendMail uploads this under ("pj[]")
+// is a guess carried over from other webmail compose forms, not confirmed
+// against Yopmail's real /writeit endpoint. A wrong field name means
+// Yopmail silently drops the attachment while SendMail still returns nil.
+type ComposeAttachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// ComposeMessage holds the fields submitted through Yopmail's compose form.
+type ComposeMessage struct {
+	To          string
+	Subject     string
+	Body        string
+	Attachments []ComposeAttachment
+}
+
+// SendMail submits msg through Yopmail's compose endpoint (/writeit) as a
+// POST form, or as multipart/form-data when msg.Attachments is non-empty.
+// See ComposeAttachment's doc comment for the caveat on attachment support.
+func (y *Yopmail) SendMail(ctx context.Context, msg ComposeMessage) error {
+	params := url.Values{
+		"login": {y.Username},
+		"to":    {msg.To},
+		"subj":  {msg.Subject},
+		"body":  {msg.Body},
+	}
+
+	var (
+		resp *http.Response
+		err  error
+	)
+	if len(msg.Attachments) > 0 {
+		resp, err = y.requestMultipart(ctx, fmt.Sprintf("%swriteit", y.URL), params, msg.Attachments, "send mail")
+	} else {
+		resp, err = y.requestForm(ctx, fmt.Sprintf("%swriteit", y.URL), params, "send mail")
+	}
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// requestForm POSTs params as a form body to requestURL, reusing Request's
+// yp/yj/version/ytime handling via prepareParams/setYtimeCookie.
+func (y *Yopmail) requestForm(ctx context.Context, requestURL string, params url.Values, contextDesc string) (*http.Response, error) {
+	params, err := y.prepareParams(ctx, params, contextDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	y.setYtimeCookie(requestURL)
+
+	// Create request
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("[x] Couldn't create request for %s: %w", contextDesc, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	// Make request
+	resp, err := y.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("[x] Couldn't process %s request: %w", contextDesc, err)
+	}
+
+	// Check for status code
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		if resp.StatusCode == 429 {
+			return nil, ErrTooManyRequests
+		}
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return resp, nil
+}
+
+// requestMultipart POSTs params as form fields alongside attachments as file
+// parts, multipart/form-data, to requestURL. Like requestForm, it reuses
+// Request's yp/yj/version/ytime handling via prepareParams/setYtimeCookie.
+// See ComposeAttachment's doc comment for the caveat on the "pj[]" field name.
+func (y *Yopmail) requestMultipart(ctx context.Context, requestURL string, params url.Values, attachments []ComposeAttachment, contextDesc string) (*http.Response, error) {
+	params, err := y.prepareParams(ctx, params, contextDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	for key, values := range params {
+		for _, value := range values {
+			if err := writer.WriteField(key, value); err != nil {
+				return nil, fmt.Errorf("[x] Couldn't write %s field for %s: %w", key, contextDesc, err)
+			}
+		}
+	}
+
+	for _, attachment := range attachments {
+		part, err := writer.CreateFormFile("pj[]", attachment.Filename)
+		if err != nil {
+			return nil, fmt.Errorf("[x] Couldn't attach %s for %s: %w", attachment.Filename, contextDesc, err)
+		}
+		if _, err := part.Write(attachment.Data); err != nil {
+			return nil, fmt.Errorf("[x] Couldn't write attachment %s for %s: %w", attachment.Filename, contextDesc, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("[x] Couldn't finalize attachments for %s: %w", contextDesc, err)
+	}
+
+	y.setYtimeCookie(requestURL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, &body)
+	if err != nil {
+		return nil, fmt.Errorf("[x] Couldn't create request for %s: %w", contextDesc, err)
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := y.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("[x] Couldn't process %s request: %w", contextDesc, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		if resp.StatusCode == 429 {
+			return nil, ErrTooManyRequests
+		}
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,138 @@
+package yopmail
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestSendMailUsesPostForm verifies that SendMail submits the compose form
+// as a POST with the fields form-encoded in the body, not as GET query
+// parameters, against a local test server standing in for Yopmail.
+func TestSendMailUsesPostForm(t *testing.T) {
+	var gotMethod string
+	var gotTo, gotSubj, gotBody string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse form: %v", err)
+		}
+		gotTo = r.FormValue("to")
+		gotSubj = r.FormValue("subj")
+		gotBody = r.FormValue("body")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// Build the client without the network round trips NewYopmail would
+	// normally make, and point it at the local test server instead of the
+	// real Yopmail service.
+	y, err := newYopmailClient(testUsername, "")
+	if err != nil {
+		t.Fatalf("Failed to create Yopmail client: %v", err)
+	}
+	y.URL = server.URL + "/"
+	y.mu.Lock()
+	y.yp = "test-yp"
+	y.yj = "test-yj"
+	y.version = "9.0"
+	y.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	msg := ComposeMessage{To: "recipient@yopmail.com", Subject: "Hi", Body: "Hello there"}
+	if err := y.SendMail(ctx, msg); err != nil {
+		t.Fatalf("SendMail failed: %v", err)
+	}
+
+	if gotMethod != http.MethodPost {
+		t.Errorf("Expected POST, got %s", gotMethod)
+	}
+	if gotTo != msg.To {
+		t.Errorf("Expected to=%q, got %q", msg.To, gotTo)
+	}
+	if gotSubj != msg.Subject {
+		t.Errorf("Expected subj=%q, got %q", msg.Subject, gotSubj)
+	}
+	if gotBody != msg.Body {
+		t.Errorf("Expected body=%q, got %q", msg.Body, gotBody)
+	}
+}
+
+// TestSendMailWithAttachmentUsesMultipart verifies that SendMail switches to
+// a multipart/form-data POST carrying both the form fields and the
+// attachment bytes when msg.Attachments is non-empty.
+func TestSendMailWithAttachmentUsesMultipart(t *testing.T) {
+	var gotContentType, gotTo, gotSubj, gotFilename string
+	var gotData []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("Failed to parse multipart form: %v", err)
+		}
+		gotTo = r.FormValue("to")
+		gotSubj = r.FormValue("subj")
+
+		file, header, err := r.FormFile("pj[]")
+		if err != nil {
+			t.Fatalf("Failed to read attachment part: %v", err)
+		}
+		defer file.Close()
+		gotFilename = header.Filename
+		gotData, err = io.ReadAll(file)
+		if err != nil {
+			t.Fatalf("Failed to read attachment data: %v", err)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	y, err := newYopmailClient(testUsername, "")
+	if err != nil {
+		t.Fatalf("Failed to create Yopmail client: %v", err)
+	}
+	y.URL = server.URL + "/"
+	y.mu.Lock()
+	y.yp = "test-yp"
+	y.yj = "test-yj"
+	y.version = "9.0"
+	y.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	msg := ComposeMessage{
+		To:      "recipient@yopmail.com",
+		Subject: "Hi",
+		Body:    "Hello there",
+		Attachments: []ComposeAttachment{
+			{Filename: "note.txt", ContentType: "text/plain", Data: []byte("attachment body")},
+		},
+	}
+	if err := y.SendMail(ctx, msg); err != nil {
+		t.Fatalf("SendMail failed: %v", err)
+	}
+
+	if !strings.HasPrefix(gotContentType, "multipart/form-data") {
+		t.Errorf("Expected multipart/form-data content type, got %q", gotContentType)
+	}
+	if gotTo != msg.To {
+		t.Errorf("Expected to=%q, got %q", msg.To, gotTo)
+	}
+	if gotSubj != msg.Subject {
+		t.Errorf("Expected subj=%q, got %q", msg.Subject, gotSubj)
+	}
+	if gotFilename != "note.txt" {
+		t.Errorf("Expected filename=%q, got %q", "note.txt", gotFilename)
+	}
+	if string(gotData) != "attachment body" {
+		t.Errorf("Expected attachment data %q, got %q", "attachment body", string(gotData))
+	}
+}
@@ -0,0 +1,176 @@
+package yopmail
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"jaytaylor.com/html2text"
+)
+
+// Attachment describes a file attached to or embedded in a Mail.
+type Attachment struct {
+	ContentType string
+	Filename    string
+	Size        int64
+
+	url    string
+	client *http.Client
+}
+
+// Fetch downloads the attachment's bytes from Yopmail's attachment endpoint.
+func (a *Attachment) Fetch(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 429 {
+		return nil, ErrTooManyRequests
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// Mail is a parsed representation of a Yopmail message, with headers and
+// attachments extracted from the mail page's HTML.
+type Mail struct {
+	From        string
+	To          string
+	Subject     string
+	Date        string
+	HTML        string
+	Text        string
+	Attachments []*Attachment
+
+	MailID   string
+	Username string
+}
+
+// GetMailMessage fetches a mail and parses it into a Mail, extracting
+// From/To/Subject/Date headers, a plain-text body converted from the HTML,
+// and any attached or inline parts. Use GetMailBody instead if only the raw
+// HTML of the message body is needed.
+func (y *Yopmail) GetMailMessage(ctx context.Context, mailID string, showImage bool) (*Mail, error) {
+	doc, finalID, err := y.fetchMailDocument(ctx, mailID, showImage)
+	if err != nil {
+		return nil, err
+	}
+
+	return y.parseMailMessage(doc, finalID)
+}
+
+// parseMailMessage builds a Mail from a fully-parsed mail page document.
+//
+// The From/To/Subject/Date headers live in the #mailhaut header bar that
+// wraps div#mail, not inside it, so doc must be the full page (see
+// fetchMailDocument), not just the #mail subtree. Yopmail's exact class/id
+// names for that chrome aren't documented anywhere in this repo and neither
+// selector set below has been confirmed against a live mailbox, so each
+// field tries a specific guess first (".yp" etc., carried over from an
+// earlier version of this parser) and falls back to a looser substring match
+// if that finds nothing.
+func (y *Yopmail) parseMailMessage(doc *goquery.Document, finalID string) (*Mail, error) {
+	mailDiv := doc.Find("div#mail")
+
+	mailHTML := ""
+	if mailDiv.Length() > 0 {
+		if html, err := goquery.OuterHtml(mailDiv); err == nil {
+			mailHTML = html
+		}
+	}
+
+	text, err := html2text.FromString(mailHTML, html2text.Options{PrettyTables: false})
+	if err != nil {
+		return nil, err
+	}
+
+	header := doc.Find("#mailhaut")
+
+	mail := &Mail{
+		From:     firstMatchText(header, ".yp", "a[href^='mailto:']", "[class*='from'], [id*='from']"),
+		To:       firstMatchText(header, ".ynh", "[class*='to'], [id*='to']"),
+		Subject:  firstMatchText(header, ".ym", "[class*='subj'], [id*='subj']"),
+		Date:     firstMatchText(header, ".yd", "[class*='date'], [id*='date'], [class*='hour'], [id*='hour']"),
+		HTML:     mailHTML,
+		Text:     strings.TrimSpace(text),
+		MailID:   finalID,
+		Username: y.Username,
+	}
+
+	attachments := firstMatchSelection(doc.Selection, "a.ylea, div.piece_attach a", "div#mail a[download]")
+	attachments.Each(func(i int, s *goquery.Selection) {
+		href, exists := s.Attr("href")
+		if !exists {
+			return
+		}
+
+		filename := s.AttrOr("download", "")
+		if filename == "" {
+			filename = strings.TrimSpace(s.Text())
+		}
+
+		attachment := &Attachment{
+			ContentType: s.AttrOr("data-type", s.AttrOr("type", "")),
+			Filename:    filename,
+			url:         y.resolveAttachmentURL(href),
+			client:      y.Client,
+		}
+
+		if sizeAttr := s.AttrOr("data-size", ""); sizeAttr != "" {
+			if size, err := strconv.ParseInt(sizeAttr, 10, 64); err == nil {
+				attachment.Size = size
+			}
+		}
+
+		mail.Attachments = append(mail.Attachments, attachment)
+	})
+
+	return mail, nil
+}
+
+// firstMatchText returns the trimmed text of the first element matching any
+// of selectors, searched in order, scoped to sel. It returns "" if none match.
+func firstMatchText(sel *goquery.Selection, selectors ...string) string {
+	found := firstMatchSelection(sel, selectors...)
+	if found.Length() == 0 {
+		return ""
+	}
+	return strings.TrimSpace(found.First().Text())
+}
+
+// firstMatchSelection returns the result of sel.Find for the first selector,
+// searched in order, that matches at least one element. It returns an empty
+// selection if none match.
+func firstMatchSelection(sel *goquery.Selection, selectors ...string) *goquery.Selection {
+	for _, selector := range selectors {
+		if found := sel.Find(selector); found.Length() > 0 {
+			return found
+		}
+	}
+	return sel.Find(selectors[len(selectors)-1])
+}
+
+// resolveAttachmentURL turns a relative attachment href from the mail HTML
+// into an absolute URL against the client's base URL.
+func (y *Yopmail) resolveAttachmentURL(href string) string {
+	if strings.HasPrefix(href, "http://") || strings.HasPrefix(href, "https://") {
+		return href
+	}
+
+	return fmt.Sprintf("%s%s", y.URL, strings.TrimPrefix(href, "/"))
+}
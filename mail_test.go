@@ -0,0 +1,169 @@
+package yopmail
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// TestParseMailMessageFixtureSpecificSelectors verifies the primary
+// ".yp"/".ynh"/".ym"/".yd" selector guesses against a fixture using those
+// class names, independent of Yopmail's live markup.
+func TestParseMailMessageFixtureSpecificSelectors(t *testing.T) {
+	const fixture = `
+<html>
+<body>
+<div id="mailhaut">
+	<div class="yp">sender@example.com</div>
+	<div class="ynh">recipient@yopmail.com</div>
+	<div class="ym">Hello world</div>
+	<div class="yd">2026-07-26 10:00</div>
+</div>
+<div id="mail">
+	<p>Body text</p>
+</div>
+</body>
+</html>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(fixture))
+	if err != nil {
+		t.Fatalf("Failed to parse fixture: %v", err)
+	}
+
+	y := &Yopmail{Username: testUsername, URL: "https://yopmail.com/en/"}
+
+	mail, err := y.parseMailMessage(doc, "m123")
+	if err != nil {
+		t.Fatalf("parseMailMessage failed: %v", err)
+	}
+
+	if mail.From != "sender@example.com" {
+		t.Errorf("Expected From %q, got %q", "sender@example.com", mail.From)
+	}
+	if mail.To != "recipient@yopmail.com" {
+		t.Errorf("Expected To %q, got %q", "recipient@yopmail.com", mail.To)
+	}
+	if mail.Subject != "Hello world" {
+		t.Errorf("Expected Subject %q, got %q", "Hello world", mail.Subject)
+	}
+	if mail.Date != "2026-07-26 10:00" {
+		t.Errorf("Expected Date %q, got %q", "2026-07-26 10:00", mail.Date)
+	}
+}
+
+// TestParseMailMessageFixture verifies the fallback header/attachment
+// extraction logic against a fixed HTML fixture that only matches the looser
+// substring selectors, independent of Yopmail's live markup.
+func TestParseMailMessageFixture(t *testing.T) {
+	const fixture = `
+<html>
+<body>
+<div id="mailhaut">
+	<a class="fromaddr" href="mailto:sender@example.com">sender@example.com</a>
+	<div class="to">recipient@yopmail.com</div>
+	<div class="subj">Hello world</div>
+	<div class="date">2026-07-26 10:00</div>
+</div>
+<div id="mail">
+	<p>Body text</p>
+	<a href="/attachment/1" download="report.pdf" data-type="application/pdf" data-size="1234">report.pdf</a>
+</div>
+</body>
+</html>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(fixture))
+	if err != nil {
+		t.Fatalf("Failed to parse fixture: %v", err)
+	}
+
+	y := &Yopmail{Username: testUsername, URL: "https://yopmail.com/en/"}
+
+	mail, err := y.parseMailMessage(doc, "m123")
+	if err != nil {
+		t.Fatalf("parseMailMessage failed: %v", err)
+	}
+
+	if mail.From != "sender@example.com" {
+		t.Errorf("Expected From %q, got %q", "sender@example.com", mail.From)
+	}
+	if mail.To != "recipient@yopmail.com" {
+		t.Errorf("Expected To %q, got %q", "recipient@yopmail.com", mail.To)
+	}
+	if mail.Subject != "Hello world" {
+		t.Errorf("Expected Subject %q, got %q", "Hello world", mail.Subject)
+	}
+	if mail.Date != "2026-07-26 10:00" {
+		t.Errorf("Expected Date %q, got %q", "2026-07-26 10:00", mail.Date)
+	}
+	if !strings.Contains(mail.Text, "Body text") {
+		t.Errorf("Expected Text to contain %q, got %q", "Body text", mail.Text)
+	}
+
+	if len(mail.Attachments) != 1 {
+		t.Fatalf("Expected 1 attachment, got %d", len(mail.Attachments))
+	}
+
+	att := mail.Attachments[0]
+	if att.Filename != "report.pdf" {
+		t.Errorf("Expected attachment filename %q, got %q", "report.pdf", att.Filename)
+	}
+	if att.ContentType != "application/pdf" {
+		t.Errorf("Expected attachment content type %q, got %q", "application/pdf", att.ContentType)
+	}
+	if att.Size != 1234 {
+		t.Errorf("Expected attachment size %d, got %d", 1234, att.Size)
+	}
+	if att.url != "https://yopmail.com/en/attachment/1" {
+		t.Errorf("Expected attachment URL %q, got %q", "https://yopmail.com/en/attachment/1", att.url)
+	}
+}
+
+// TestGetMailMessage verifies that GetMailMessage returns a parsed Mail whose
+// HTML and identifying fields match the underlying mail page response.
+func TestGetMailMessage(t *testing.T) {
+	y, err := NewYopmail(testUsername, "")
+	if err != nil {
+		t.Fatalf("Failed to create Yopmail client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mailIDs, err := y.GetMailIDs(ctx, 1)
+	if err != nil {
+		t.Fatalf("Failed to get mail IDs: %v", err)
+	}
+	if len(mailIDs) == 0 {
+		t.Log("WARNING: No emails found in test inbox, skipping")
+		return
+	}
+
+	time.Sleep(requestDelay)
+
+	mail, err := y.GetMailMessage(ctx, mailIDs[0], false)
+	if err != nil {
+		t.Fatalf("Failed to get mail message: %v", err)
+	}
+
+	if mail.Username != testUsername {
+		t.Errorf("Expected username %s, got %s", testUsername, mail.Username)
+	}
+
+	if mail.HTML == "" {
+		t.Error("Expected non-empty mail HTML")
+	}
+	if mail.Subject == "" {
+		t.Error("Expected non-empty Subject; header selectors may no longer match Yopmail's markup")
+	}
+	if mail.From == "" {
+		t.Error("Expected non-empty From; header selectors may no longer match Yopmail's markup")
+	}
+	if mail.Date == "" {
+		t.Error("Expected non-empty Date; header selectors may no longer match Yopmail's markup")
+	}
+
+	t.Logf("Subject: %q, From: %q, %d attachment(s)", mail.Subject, mail.From, len(mail.Attachments))
+}
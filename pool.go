@@ -0,0 +1,127 @@
+package yopmail
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// FetchOptions configures FetchInboxes.
+type FetchOptions struct {
+	// Page is the inbox page to fetch for each mailbox.
+	Page int
+	// Concurrency bounds how many mailboxes are fetched in parallel. Defaults to 5.
+	Concurrency int
+	// Delay is the minimum spacing enforced between requests across all
+	// mailboxes, so the combined traffic still looks sequential to Yopmail's
+	// CAPTCHA heuristics. Defaults to 200ms.
+	Delay time.Duration
+	// Proxy, if set, is used for every mailbox's client.
+	Proxy string
+}
+
+// FetchInboxes fetches the mail IDs and bodies for multiple mailboxes
+// concurrently, bounding parallelism to opts.Concurrency and pacing requests
+// with a shared rate limiter so many throwaway mailboxes can be pulled at
+// once without hand-rolling goroutine coordination or tripping Yopmail's
+// CAPTCHA. It cancels all in-flight requests as soon as any mailbox returns
+// an error; no request is retried, including one that fails with
+// ErrTooManyRequests.
+func FetchInboxes(ctx context.Context, usernames []string, opts FetchOptions) (map[string][]*Mail, error) {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 5
+	}
+	if opts.Delay <= 0 {
+		opts.Delay = 200 * time.Millisecond
+	}
+
+	var (
+		mu      sync.Mutex
+		results = make(map[string][]*Mail, len(usernames))
+		limiter = newRateLimiter(opts.Delay)
+	)
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(opts.Concurrency)
+
+	for _, username := range usernames {
+		username := username // capture for the goroutine below (pre-Go 1.22 semantics)
+		g.Go(func() error {
+			// NewYopmailWithContext also fetches the version over the
+			// network, so it's paced and bound to gctx like the requests below.
+			limiter.Wait(gctx)
+			y, err := NewYopmailWithContext(gctx, username, opts.Proxy)
+			if err != nil {
+				return fmt.Errorf("[x] Couldn't create client for %s: %w", username, err)
+			}
+
+			limiter.Wait(gctx)
+			ids, err := y.GetMailIDs(gctx, opts.Page)
+			if err != nil {
+				return fmt.Errorf("[x] Couldn't list mail for %s: %w", username, err)
+			}
+
+			mails := make([]*Mail, 0, len(ids))
+			for _, id := range ids {
+				limiter.Wait(gctx)
+				mail, err := y.GetMailMessage(gctx, id, false)
+				if err != nil {
+					return fmt.Errorf("[x] Couldn't fetch mail %s for %s: %w", id, username, err)
+				}
+				mails = append(mails, mail)
+			}
+
+			mu.Lock()
+			results[username] = mails
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// rateLimiter enforces a minimum delay between successive Wait calls, shared
+// across goroutines, so concurrent mailbox fetches collectively still pace
+// like a single sequential client.
+type rateLimiter struct {
+	mu       sync.Mutex
+	delay    time.Duration
+	lastCall time.Time
+}
+
+func newRateLimiter(delay time.Duration) *rateLimiter {
+	return &rateLimiter{delay: delay}
+}
+
+// Wait blocks until at least delay has passed since the previous Wait call
+// across all goroutines sharing this limiter, or ctx is cancelled.
+func (r *rateLimiter) Wait(ctx context.Context) {
+	r.mu.Lock()
+	wait := time.Until(r.lastCall.Add(r.delay))
+	if wait < 0 {
+		wait = 0
+	}
+	r.lastCall = time.Now().Add(wait)
+	r.mu.Unlock()
+
+	if wait == 0 {
+		return
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,29 @@
+package yopmail
+
+import (
+	"context"
+	"testing"
+)
+
+// TestFetchInboxes verifies that FetchInboxes returns a result entry for
+// every requested mailbox, fetched concurrently. Using more than one
+// username exercises the per-goroutine username capture: with a shared
+// loop variable, every goroutine would race to the same username and the
+// result map would collapse to a single entry.
+func TestFetchInboxes(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	usernames := []string{testUsername, "go-yopmail-fetchinboxes-test"}
+
+	results, err := FetchInboxes(ctx, usernames, FetchOptions{Page: 1})
+	if err != nil {
+		t.Fatalf("FetchInboxes failed: %v", err)
+	}
+
+	for _, username := range usernames {
+		if _, ok := results[username]; !ok {
+			t.Errorf("Expected results to contain entry for %s", username)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package yopmail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Provider abstracts a disposable-mailbox backend so that NewClient can
+// dispatch to the right implementation based on a mailbox address's domain.
+type Provider interface {
+	// Domains returns the email domains this provider handles.
+	Domains() []string
+	// Inbox lists the mail IDs currently in address's inbox.
+	Inbox(ctx context.Context, address string) ([]string, error)
+	// Message fetches and parses a single mail by ID.
+	Message(ctx context.Context, address, mailID string) (*Mail, error)
+	// Delete removes a mail by ID from address's inbox.
+	Delete(ctx context.Context, address, mailID string) error
+}
+
+// ErrUnsupportedProvider is returned by NewClient when no registered
+// Provider advertises the mailbox address's domain.
+var ErrUnsupportedProvider = errors.New("no provider registered for this mailbox's domain")
+
+var providers []Provider
+
+// RegisterProvider adds p to the set of providers consulted by NewClient.
+// It is meant to be called from init() by packages implementing Provider.
+func RegisterProvider(p Provider) {
+	providers = append(providers, p)
+}
+
+func init() {
+	RegisterProvider(&yopmailProvider{})
+}
+
+// NewClient inspects address's domain and returns the Provider registered to
+// handle it. Yopmail and its alternate domains are handled directly; other
+// disposable-mail services can plug in by implementing Provider and calling
+// RegisterProvider.
+func NewClient(address string) (Provider, error) {
+	domain := domainOf(address)
+
+	for _, p := range providers {
+		for _, d := range p.Domains() {
+			if strings.EqualFold(d, domain) {
+				return p, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedProvider, domain)
+}
+
+func domainOf(address string) string {
+	if i := strings.LastIndex(address, "@"); i >= 0 {
+		return address[i+1:]
+	}
+	return address
+}
+
+// yopmailAlternateDomains lists the alternate domains Yopmail accepts in
+// addition to yopmail.com. It is a static fallback for Domains(), which has
+// no context to call GetAlternativeDomains; callers that need the live list
+// should use (*Yopmail).GetAlternativeDomains instead.
+var yopmailAlternateDomains = []string{
+	"yopmail.fr",
+	"yopmail.net",
+	"cool.fr.nf",
+	"jetable.fr.nf",
+}
+
+// yopmailProvider adapts Yopmail to the Provider interface. Each call creates
+// a short-lived Yopmail client for the requested address, since a Yopmail
+// instance is bound to a single username.
+type yopmailProvider struct{}
+
+func (p *yopmailProvider) Domains() []string {
+	return append([]string{"yopmail.com"}, yopmailAlternateDomains...)
+}
+
+func (p *yopmailProvider) Inbox(ctx context.Context, address string) ([]string, error) {
+	y, err := NewYopmail(address, "")
+	if err != nil {
+		return nil, err
+	}
+	return y.GetMailIDs(ctx, 1)
+}
+
+func (p *yopmailProvider) Message(ctx context.Context, address, mailID string) (*Mail, error) {
+	y, err := NewYopmail(address, "")
+	if err != nil {
+		return nil, err
+	}
+	return y.GetMailMessage(ctx, mailID, false)
+}
+
+func (p *yopmailProvider) Delete(ctx context.Context, address, mailID string) error {
+	y, err := NewYopmail(address, "")
+	if err != nil {
+		return err
+	}
+
+	resp, err := y.DeleteMail(ctx, mailID, 1)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package yopmail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrProviderNotImplemented is returned by stub providers that are
+// registered for domain dispatch but have no working implementation yet.
+var ErrProviderNotImplemented = errors.New("provider not implemented yet")
+
+func init() {
+	RegisterProvider(&stubProvider{name: "mail.tm", domains: []string{"mail.tm"}})
+	RegisterProvider(&stubProvider{name: "guerrillamail", domains: []string{
+		"guerrillamail.com", "guerrillamail.net", "guerrillamail.org", "guerrillamail.biz",
+	}})
+}
+
+// stubProvider advertises Domains for a known disposable-mail service so
+// NewClient recognizes its addresses, but reports ErrProviderNotImplemented
+// from every operation until a real implementation is added.
+type stubProvider struct {
+	name    string
+	domains []string
+}
+
+func (p *stubProvider) Domains() []string {
+	return p.domains
+}
+
+func (p *stubProvider) Inbox(ctx context.Context, address string) ([]string, error) {
+	return nil, fmt.Errorf("%s: %w", p.name, ErrProviderNotImplemented)
+}
+
+func (p *stubProvider) Message(ctx context.Context, address, mailID string) (*Mail, error) {
+	return nil, fmt.Errorf("%s: %w", p.name, ErrProviderNotImplemented)
+}
+
+func (p *stubProvider) Delete(ctx context.Context, address, mailID string) error {
+	return fmt.Errorf("%s: %w", p.name, ErrProviderNotImplemented)
+}
@@ -0,0 +1,38 @@
+package yopmail
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestNewClientDispatch verifies that NewClient returns a provider for known
+// domains and rejects unknown ones with ErrUnsupportedProvider.
+func TestNewClientDispatch(t *testing.T) {
+	cases := []struct {
+		address string
+		wantErr bool
+	}{
+		{testUsername + "@yopmail.com", false},
+		{testUsername + "@mail.tm", false},
+		{testUsername + "@example-does-not-exist.test", true},
+	}
+
+	for _, c := range cases {
+		p, err := NewClient(c.address)
+		if c.wantErr {
+			if !errors.Is(err, ErrUnsupportedProvider) {
+				t.Errorf("NewClient(%q): expected ErrUnsupportedProvider, got %v", c.address, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("NewClient(%q): unexpected error: %v", c.address, err)
+			continue
+		}
+
+		if p == nil {
+			t.Errorf("NewClient(%q): expected a non-nil provider", c.address)
+		}
+	}
+}
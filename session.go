@@ -0,0 +1,85 @@
+package yopmail
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// sessionData is the JSON-serializable snapshot of a Yopmail session.
+type sessionData struct {
+	Username string         `json:"username"`
+	URL      string         `json:"url"`
+	YP       string         `json:"yp"`
+	YJ       string         `json:"yj"`
+	YTime    string         `json:"ytime"`
+	Version  string         `json:"version"`
+	Cookies  []*http.Cookie `json:"cookies"`
+}
+
+// SaveSession serializes the extracted yp/yj/version/ytime parameters and the
+// cookies the jar returns for y.URL to w as JSON, for later restoration with
+// LoadSession. Cookies the jar would not send to y.URL are not saved, and
+// each saved cookie keeps only its name and value.
+func (y *Yopmail) SaveSession(w io.Writer) error {
+	reqURL, err := url.Parse(y.URL)
+	if err != nil {
+		return fmt.Errorf("failed to parse session URL: %w", err)
+	}
+
+	y.mu.RLock()
+	data := sessionData{
+		Username: y.Username,
+		URL:      y.URL,
+		YP:       y.yp,
+		YJ:       y.yj,
+		YTime:    y.ytime,
+		Version:  y.version,
+		Cookies:  y.Client.Jar.Cookies(reqURL),
+	}
+	y.mu.RUnlock()
+
+	return json.NewEncoder(w).Encode(data)
+}
+
+// LoadSession restores the yp/yj/version/ytime parameters and cookies
+// previously written by SaveSession.
+func (y *Yopmail) LoadSession(r io.Reader) error {
+	var data sessionData
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return fmt.Errorf("failed to decode session: %w", err)
+	}
+
+	reqURL, err := url.Parse(data.URL)
+	if err != nil {
+		return fmt.Errorf("failed to parse session URL: %w", err)
+	}
+
+	y.Client.Jar.SetCookies(reqURL, data.Cookies)
+
+	y.mu.Lock()
+	y.yp = data.YP
+	y.yj = data.YJ
+	y.ytime = data.YTime
+	y.version = data.Version
+	y.mu.Unlock()
+
+	return nil
+}
+
+// NewYopmailFromSession creates a Yopmail client for username and restores a
+// session previously captured with SaveSession.
+func NewYopmailFromSession(username string, proxies string, r io.Reader) (*Yopmail, error) {
+	yopmail, err := newYopmailClient(username, proxies)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yopmail.LoadSession(r); err != nil {
+		return nil, err
+	}
+
+	return yopmail, nil
+}
@@ -0,0 +1,57 @@
+package yopmail
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+)
+
+// TestSaveLoadSession verifies that a session saved from one client can be
+// loaded into another and restores the same yp/yj/version parameters.
+func TestSaveLoadSession(t *testing.T) {
+	y, err := NewYopmail(testUsername, "")
+	if err != nil {
+		t.Fatalf("Failed to create Yopmail client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	// Force initialization of yp/yj.
+	resp, err := y.GetInbox(ctx, 1)
+	if err != nil {
+		t.Fatalf("Failed to get inbox: %v", err)
+	}
+	resp.Body.Close()
+
+	var buf bytes.Buffer
+	if err := y.SaveSession(&buf); err != nil {
+		t.Fatalf("SaveSession failed: %v", err)
+	}
+
+	time.Sleep(requestDelay)
+
+	restored, err := NewYopmailFromSession(testUsername, "", &buf)
+	if err != nil {
+		t.Fatalf("NewYopmailFromSession failed: %v", err)
+	}
+
+	y.mu.RLock()
+	wantYP, wantYJ, wantVersion := y.yp, y.yj, y.version
+	y.mu.RUnlock()
+
+	restored.mu.RLock()
+	gotYP, gotYJ, gotVersion := restored.yp, restored.yj, restored.version
+	restored.mu.RUnlock()
+
+	if gotYP != wantYP {
+		t.Errorf("Expected restored yp %q, got %q", wantYP, gotYP)
+	}
+	if gotYJ != wantYJ {
+		t.Errorf("Expected restored yj %q, got %q", wantYJ, gotYJ)
+	}
+	if gotVersion != wantVersion {
+		t.Errorf("Expected restored version %q, got %q", wantVersion, gotVersion)
+	}
+}
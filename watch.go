@@ -0,0 +1,144 @@
+package yopmail
+
+import (
+	"context"
+	"time"
+)
+
+// MailEventType identifies the kind of inbox change reported by Watch.
+type MailEventType int
+
+const (
+	// MailArrived indicates a mail ID that was not previously seen appeared in the inbox.
+	MailArrived MailEventType = iota
+	// MailDeleted indicates a previously seen mail ID is no longer in the inbox.
+	MailDeleted
+)
+
+// MailEvent describes a single inbox change detected by Watch.
+type MailEvent struct {
+	Type   MailEventType
+	MailID string
+}
+
+// WatchOptions configures the polling behavior of Watch.
+type WatchOptions struct {
+	// Page is the inbox page to poll, same meaning as in GetMailIDs.
+	Page int
+	// Interval is the delay between successive polls. Defaults to 30s.
+	Interval time.Duration
+	// MaxBackoff caps the delay applied after a failed poll (a 429 response,
+	// a transient network error, or anything else GetMailIDs returns).
+	// Defaults to 5 minutes.
+	MaxBackoff time.Duration
+}
+
+// Watch polls GetMailIDs on opts.Interval, diffing each result against the
+// previously seen ID set, and streams a MailEvent for every mail that arrives
+// or disappears. A failed poll backs off by doubling the interval up to
+// opts.MaxBackoff and is retried rather than treated as fatal. The returned
+// channel is only closed once ctx is cancelled.
+func (y *Yopmail) Watch(ctx context.Context, opts WatchOptions) (<-chan MailEvent, error) {
+	if opts.Interval <= 0 {
+		opts.Interval = 30 * time.Second
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 5 * time.Minute
+	}
+
+	ids, err := y.GetMailIDs(ctx, opts.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		seen[id] = struct{}{}
+	}
+
+	events := make(chan MailEvent)
+
+	go func() {
+		defer close(events)
+
+		delay := opts.Interval
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+
+			ids, err := y.GetMailIDs(ctx, opts.Page)
+			if err != nil {
+				// ctx being done is the only fatal case; everything else is
+				// retried after backing off.
+				if ctx.Err() != nil {
+					return
+				}
+
+				delay *= 2
+				if delay > opts.MaxBackoff {
+					delay = opts.MaxBackoff
+				}
+				timer.Reset(delay)
+				continue
+			}
+
+			delay = opts.Interval
+
+			current := make(map[string]struct{}, len(ids))
+			for _, id := range ids {
+				current[id] = struct{}{}
+				if _, ok := seen[id]; !ok {
+					select {
+					case events <- MailEvent{Type: MailArrived, MailID: id}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+
+			for id := range seen {
+				if _, ok := current[id]; !ok {
+					select {
+					case events <- MailEvent{Type: MailDeleted, MailID: id}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+
+			seen = current
+			timer.Reset(delay)
+		}
+	}()
+
+	return events, nil
+}
+
+// WatchOnce fetches the current mail IDs on the given page and returns only
+// the ones not present in cursor, without starting a background poll loop.
+func (y *Yopmail) WatchOnce(ctx context.Context, page int, cursor []string) ([]string, error) {
+	ids, err := y.GetMailIDs(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(cursor))
+	for _, id := range cursor {
+		seen[id] = struct{}{}
+	}
+
+	newIDs := make([]string, 0)
+	for _, id := range ids {
+		if _, ok := seen[id]; !ok {
+			newIDs = append(newIDs, id)
+		}
+	}
+
+	return newIDs, nil
+}
@@ -0,0 +1,107 @@
+package yopmail
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestWatchOnceCursor verifies that WatchOnce only returns IDs absent from
+// the supplied cursor, using the live test mailbox's current inbox state.
+func TestWatchOnceCursor(t *testing.T) {
+	y, err := NewYopmail(testUsername, "")
+	if err != nil {
+		t.Fatalf("Failed to create Yopmail client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	ids, err := y.GetMailIDs(ctx, 1)
+	if err != nil {
+		t.Fatalf("Failed to get mail IDs: %v", err)
+	}
+
+	time.Sleep(requestDelay)
+
+	// Using the full ID set as the cursor should report no new mail.
+	newIDs, err := y.WatchOnce(ctx, 1, ids)
+	if err != nil {
+		t.Fatalf("WatchOnce failed: %v", err)
+	}
+	if len(newIDs) != 0 {
+		t.Errorf("Expected no new mail IDs with a full cursor, got %v", newIDs)
+	}
+
+	time.Sleep(requestDelay)
+
+	// An empty cursor should report every mail currently in the inbox as new.
+	allNew, err := y.WatchOnce(ctx, 1, nil)
+	if err != nil {
+		t.Fatalf("WatchOnce failed: %v", err)
+	}
+	if !reflect.DeepEqual(allNew, ids) {
+		t.Errorf("Expected WatchOnce with empty cursor to report %v, got %v", ids, allNew)
+	}
+}
+
+// TestWatchSurvivesTransientError verifies that Watch keeps polling (and
+// eventually reports a MailEvent) after a poll fails with a non-429 error,
+// instead of closing its channel on the first such error.
+func TestWatchSurvivesTransientError(t *testing.T) {
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+
+		// First call: the initial seed fetch inside Watch, empty inbox.
+		// Next two calls: simulate transient failures (not 429).
+		// From then on: report one mail so Watch has something to diff.
+		switch {
+		case n == 1:
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`<html><body></body></html>`))
+		case n <= 3:
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`<html><body><div class="m" id="abc123"></div></body></html>`))
+		}
+	}))
+	defer server.Close()
+
+	y, err := newYopmailClient(testUsername, "")
+	if err != nil {
+		t.Fatalf("Failed to create Yopmail client: %v", err)
+	}
+	y.URL = server.URL + "/"
+	y.mu.Lock()
+	y.yp = "test-yp"
+	y.yj = "test-yj"
+	y.version = "9.0"
+	y.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	events, err := y.Watch(ctx, WatchOptions{Interval: 10 * time.Millisecond, MaxBackoff: 20 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("Watch failed: %v", err)
+	}
+
+	select {
+	case ev, ok := <-events:
+		if !ok {
+			t.Fatal("Watch closed its channel instead of retrying past the transient error")
+		}
+		if ev.Type != MailArrived || ev.MailID != "abc123" {
+			t.Errorf("Expected MailArrived for abc123, got %+v", ev)
+		}
+	case <-ctx.Done():
+		t.Fatal("Timed out waiting for Watch to recover from a transient error")
+	}
+}
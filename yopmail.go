@@ -74,6 +74,34 @@ type Yopmail struct {
 
 // NewYopmail creates a new Yopmail instance
 func NewYopmail(username string, proxies string) (*Yopmail, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return NewYopmailWithContext(ctx, username, proxies)
+}
+
+// NewYopmailWithContext is like NewYopmail, but uses ctx for the initial
+// version fetch instead of an internal 10-second timeout. Use this when the
+// caller needs that request itself to be cancellable, e.g. FetchInboxes,
+// which cancels all in-flight mailbox setup as soon as one mailbox errors.
+func NewYopmailWithContext(ctx context.Context, username string, proxies string) (*Yopmail, error) {
+	yopmail, err := newYopmailClient(username, proxies)
+	if err != nil {
+		return nil, err
+	}
+
+	if version, err := yopmail.FindVersion(ctx); err == nil {
+		yopmail.version = version
+	}
+
+	return yopmail, nil
+}
+
+// newYopmailClient builds a Yopmail instance with its HTTP client, cookie jar,
+// and proxy configured, but without performing any network requests. It is
+// shared by NewYopmail, which additionally fetches the current version, and
+// NewYopmailFromSession, which restores it from a saved session instead.
+func newYopmailClient(username string, proxies string) (*Yopmail, error) {
 	// Validate username
 	if !usernameRegex.MatchString(username) {
 		return nil, errors.New("username is not valid")
@@ -121,14 +149,6 @@ func NewYopmail(username string, proxies string) (*Yopmail, error) {
 		transport.Proxy = http.ProxyURL(proxyURL)
 	}
 
-	// Find version
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	if version, err := yopmail.FindVersion(ctx); err == nil {
-		yopmail.version = version
-	}
-
 	return yopmail, nil
 }
 
@@ -178,19 +198,24 @@ func (y *Yopmail) FindVersion(ctx context.Context) (string, error) {
 	return "", ErrVersionNotFound
 }
 
-// Request makes a request to the Yopmail service with necessary parameters
-func (y *Yopmail) Request(ctx context.Context, requestURL string, params url.Values, contextDesc string) (*http.Response, error) {
+// prepareParams ensures the yp/yj parameters are initialized and merges
+// them, together with the current version, into params, returning the merged values ready to be sent as
+// a query string or form/multipart body. It is shared by Request and the
+// form-submitting helpers in compose.go so that a future change to how
+// these parameters are obtained or merged doesn't need to be mirrored by hand.
+func (y *Yopmail) prepareParams(ctx context.Context, params url.Values, contextDesc string) (url.Values, error) {
 	// Check and initialize parameters if needed
 	if err := y.ensureParameters(ctx); err != nil {
 		return nil, fmt.Errorf("[x] Couldn't initialize parameters for %s request: %w", contextDesc, err)
 	}
 
-	// Add required parameters
-	y.mu.RLock()
 	if params == nil {
 		params = url.Values{}
 	}
 
+	y.mu.RLock()
+	defer y.mu.RUnlock()
+
 	if y.yp != "" && params.Get("yp") == "" {
 		params.Set("yp", y.yp)
 	}
@@ -202,11 +227,38 @@ func (y *Yopmail) Request(ctx context.Context, requestURL string, params url.Val
 	if y.version != "" && params.Get("v") == "" {
 		params.Set("v", y.version)
 	}
-	y.mu.RUnlock()
 
-	// Add ytime
+	return params, nil
+}
+
+// setYtimeCookie refreshes the 'ytime' parameter and stores it as a cookie
+// for requestURL's domain, as Yopmail expects it alongside yp/yj/version on
+// every request. Shared by Request and the form-submitting helpers in
+// compose.go.
+func (y *Yopmail) setYtimeCookie(requestURL string) {
 	ytime := y.addYtime()
 
+	reqURLParsed, err := url.Parse(requestURL)
+	if err == nil {
+		y.Client.Jar.SetCookies(reqURLParsed, []*http.Cookie{
+			{
+				Name:  "ytime",
+				Value: ytime,
+				Path:  "/",
+			},
+		})
+	}
+}
+
+// Request makes a request to the Yopmail service with necessary parameters
+func (y *Yopmail) Request(ctx context.Context, requestURL string, params url.Values, contextDesc string) (*http.Response, error) {
+	params, err := y.prepareParams(ctx, params, contextDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	y.setYtimeCookie(requestURL)
+
 	// Add query parameters to URL
 	reqURL := requestURL
 	if len(params) > 0 {
@@ -219,18 +271,6 @@ func (y *Yopmail) Request(ctx context.Context, requestURL string, params url.Val
 		return nil, fmt.Errorf("[x] Couldn't create request for %s: %w", contextDesc, err)
 	}
 
-	// Add ytime cookie to request URL domain
-	reqURLParsed, err := url.Parse(requestURL)
-	if err == nil {
-		y.Client.Jar.SetCookies(reqURLParsed, []*http.Cookie{
-			{
-				Name:  "ytime",
-				Value: ytime,
-				Path:  "/",
-			},
-		})
-	}
-
 	// Make request
 	resp, err := y.Client.Do(req)
 	if err != nil {
@@ -412,8 +452,12 @@ func (y *Yopmail) GetMailIDs(ctx context.Context, page int) ([]string, error) {
 	return mailIDs, nil
 }
 
-// GetMailBody gets the body of a mail
-func (y *Yopmail) GetMailBody(ctx context.Context, mailID string, showImage bool) (*YopmailHTML, error) {
+// fetchMailDocument fetches the mail page for mailID and parses the full
+// response into a goquery.Document, covering the #mailhaut header chrome as
+// well as the #mail body. Callers that only need the message body should use
+// GetMailBody; fetchMailDocument exists for callers like GetMailMessage that
+// also need data rendered outside div#mail.
+func (y *Yopmail) fetchMailDocument(ctx context.Context, mailID string, showImage bool) (*goquery.Document, string, error) {
 	// Determine ID prefix based on whether to show images
 	finalID := "m" + mailID
 	if showImage {
@@ -427,16 +471,26 @@ func (y *Yopmail) GetMailBody(ctx context.Context, mailID string, showImage bool
 
 	resp, err := y.Request(ctx, fmt.Sprintf("%smail", y.URL), params, "mail body")
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(bodyBytes))
+		return nil, "", fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
 	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return doc, finalID, nil
+}
+
+// GetMailBody gets the body of a mail
+func (y *Yopmail) GetMailBody(ctx context.Context, mailID string, showImage bool) (*YopmailHTML, error) {
+	doc, finalID, err := y.fetchMailDocument(ctx, mailID, showImage)
 	if err != nil {
 		return nil, err
 	}